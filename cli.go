@@ -0,0 +1,27 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// newRootCmd builds the allora-offchain-node CLI. `run` is also the root
+// command's own action, so invoking the binary with no subcommand keeps
+// behaving the way it always has: load config and run the spawner.
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "allora-offchain-node",
+		Short: "Allora offchain node: submits worker and reputer payloads on a topic's behalf",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runNode()
+		},
+	}
+
+	root.AddCommand(newRunCmd())
+	root.AddCommand(newRegisterCmd())
+	root.AddCommand(newStakeCmd())
+	root.AddCommand(newTopicCmd())
+	root.AddCommand(newConformanceCmd())
+	root.AddCommand(newJournalCmd())
+
+	return root
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// newRegisterCmd implements `register worker --topic <id>` and
+// `register reputer --topic <id>`: run the existing idempotent registration
+// helpers once, for one topic, and exit with a status code reflecting
+// whether registration succeeded - without starting the full spawner loop.
+func newRegisterCmd() *cobra.Command {
+	registerCmd := &cobra.Command{
+		Use:   "register",
+		Short: "Register this node as a worker or reputer for a topic",
+	}
+
+	registerCmd.AddCommand(newRegisterWorkerCmd())
+	registerCmd.AddCommand(newRegisterReputerCmd())
+
+	return registerCmd
+}
+
+func newRegisterWorkerCmd() *cobra.Command {
+	var topicId uint64
+	cmd := &cobra.Command{
+		Use:   "worker",
+		Short: "Register this node as a worker for a topic",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			suite, err := buildUseCaseSuite()
+			if err != nil {
+				return err
+			}
+
+			for _, worker := range suite.Workers {
+				if worker.TopicId != topicId {
+					continue
+				}
+				if !suite.Node.RegisterWorkerIdempotently(worker) {
+					return fmt.Errorf("node is not registered as a worker for topic %d after attempting registration", topicId)
+				}
+				fmt.Printf("Node is registered as a worker for topic %d\n", topicId)
+				return nil
+			}
+			return fmt.Errorf("no worker config found for topic %d", topicId)
+		},
+	}
+	cmd.Flags().Uint64Var(&topicId, "topic", 0, "topic ID to register as a worker for")
+	_ = cmd.MarkFlagRequired("topic")
+	return cmd
+}
+
+func newRegisterReputerCmd() *cobra.Command {
+	var topicId uint64
+	cmd := &cobra.Command{
+		Use:   "reputer",
+		Short: "Register this node as a reputer for a topic",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			suite, err := buildUseCaseSuite()
+			if err != nil {
+				return err
+			}
+
+			for _, reputer := range suite.Reputers {
+				if reputer.TopicId != topicId {
+					continue
+				}
+				if !suite.Node.RegisterAndStakeReputerIdempotently(reputer) {
+					return fmt.Errorf("node is not registered and staked as a reputer for topic %d after attempting registration", topicId)
+				}
+				fmt.Printf("Node is registered and staked as a reputer for topic %d\n", topicId)
+				return nil
+			}
+			return fmt.Errorf("no reputer config found for topic %d", topicId)
+		},
+	}
+	cmd.Flags().Uint64Var(&topicId, "topic", 0, "topic ID to register as a reputer for")
+	_ = cmd.MarkFlagRequired("topic")
+	return cmd
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"allora_offchain_node/journal"
+	"allora_offchain_node/lib"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// newRunCmd is the node's default mode: load config and run the spawner
+// loop until interrupted. This is the same behavior the node had before it
+// grew subcommands.
+func newRunCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "run",
+		Short: "Load config and run the worker/reputer submission loop",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runNode()
+		},
+	}
+}
+
+func runNode() error {
+	if dotErr := godotenv.Load(); dotErr != nil {
+		log.Info().Msg("Unable to load .env file")
+	}
+
+	log.Info().Msg("Starting allora offchain node...")
+
+	metrics := lib.NewMetrics(lib.CounterData)
+	metrics.RegisterMetricsCounters()
+	metrics.StartMetricsServer(":2112")
+
+	spawner, err := buildUseCaseSuite()
+	if err != nil {
+		return err
+	}
+
+	spawner.Metrics = *metrics
+	spawner.OnConfigReload = func() error {
+		return reloadUserConfig(spawner)
+	}
+
+	if spawner.JournalPath != "" {
+		j, err := journal.Open(spawner.JournalPath)
+		if err != nil {
+			return fmt.Errorf("could not open submission journal at %s: %w", spawner.JournalPath, err)
+		}
+		defer j.Close()
+		spawner.Journal = j
+
+		if err := replayJournal(spawner, j); err != nil {
+			return err
+		}
+	}
+
+	adminConfig := lib.AdminConfig{
+		Enabled:     os.Getenv("ALLORA_OFFCHAIN_ADMIN_PORT") != "",
+		Port:        os.Getenv("ALLORA_OFFCHAIN_ADMIN_PORT"),
+		BearerToken: os.Getenv("ALLORA_OFFCHAIN_ADMIN_TOKEN"),
+	}
+	adminServer := lib.NewAdminServer(adminConfig, spawner)
+	adminServer.Start()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigCtx, sigCancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer sigCancel()
+
+	go func() {
+		spawner.Spawn(sigCtx)
+		cancel()
+	}()
+
+	<-sigCtx.Done()
+
+	log.Info().Msg("Stopping...")
+
+	if err := adminServer.Stop(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to stop admin server cleanly")
+	}
+
+	<-ctx.Done()
+	return nil
+}
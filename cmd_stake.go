@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// newStakeCmd implements `stake add --topic <id> --amount <uallo>` and
+// `stake remove --topic <id> --amount <uallo>`, for adjusting stake beyond
+// what RegisterAndStakeReputerIdempotently brings the node up to at
+// startup, without hand-crafting `allorad tx` invocations.
+func newStakeCmd() *cobra.Command {
+	stakeCmd := &cobra.Command{
+		Use:   "stake",
+		Short: "Add or remove this node's stake in a topic",
+	}
+
+	stakeCmd.AddCommand(newStakeAddCmd())
+	stakeCmd.AddCommand(newStakeRemoveCmd())
+
+	return stakeCmd
+}
+
+func newStakeAddCmd() *cobra.Command {
+	var topicId uint64
+	var amount int64
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add stake in a topic, in uallo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			suite, err := buildUseCaseSuite()
+			if err != nil {
+				return err
+			}
+			if err := suite.Node.AddStake(topicId, amount); err != nil {
+				return fmt.Errorf("could not add stake in topic %d: %w", topicId, err)
+			}
+			fmt.Printf("Added %d uallo of stake in topic %d\n", amount, topicId)
+			return nil
+		},
+	}
+	cmd.Flags().Uint64Var(&topicId, "topic", 0, "topic ID to add stake in")
+	cmd.Flags().Int64Var(&amount, "amount", 0, "amount to add, in uallo")
+	_ = cmd.MarkFlagRequired("topic")
+	_ = cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func newStakeRemoveCmd() *cobra.Command {
+	var topicId uint64
+	var amount int64
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove stake from a topic, in uallo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			suite, err := buildUseCaseSuite()
+			if err != nil {
+				return err
+			}
+			if err := suite.Node.RemoveStake(topicId, amount); err != nil {
+				return fmt.Errorf("could not remove stake from topic %d: %w", topicId, err)
+			}
+			fmt.Printf("Removed %d uallo of stake from topic %d\n", amount, topicId)
+			return nil
+		},
+	}
+	cmd.Flags().Uint64Var(&topicId, "topic", 0, "topic ID to remove stake from")
+	cmd.Flags().Int64Var(&amount, "amount", 0, "amount to remove, in uallo")
+	_ = cmd.MarkFlagRequired("topic")
+	_ = cmd.MarkFlagRequired("amount")
+	return cmd
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// newTopicCmd implements `topic info --topic <id>` and
+// `topic find-open-nonces --topic <id>`, letting operators bootstrap and
+// troubleshoot a topic without running the full spawner loop.
+func newTopicCmd() *cobra.Command {
+	topicCmd := &cobra.Command{
+		Use:   "topic",
+		Short: "Inspect a topic's epoch schedule, registration status, and open nonces",
+	}
+
+	topicCmd.AddCommand(newTopicInfoCmd())
+	topicCmd.AddCommand(newTopicFindOpenNoncesCmd())
+
+	return topicCmd
+}
+
+func newTopicInfoCmd() *cobra.Command {
+	var topicId uint64
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Print a topic's epoch length, current block, and this node's registration/stake status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			suite, err := buildUseCaseSuite()
+			if err != nil {
+				return err
+			}
+
+			info, err := suite.Node.GetTopicInfo(topicId)
+			if err != nil {
+				return fmt.Errorf("could not get info for topic %d: %w", topicId, err)
+			}
+
+			fmt.Printf("Topic:              %d\n", info.TopicId)
+			fmt.Printf("Epoch length:       %d blocks\n", info.EpochLength)
+			fmt.Printf("Current block:      %d\n", info.CurrentBlockHeight)
+			fmt.Printf("Next epoch end:     %d\n", info.NextEpochEnd)
+			fmt.Printf("Worker registered:  %t\n", info.WorkerRegistered)
+			fmt.Printf("Reputer registered: %t\n", info.ReputerRegistered)
+			if info.ReputerRegistered {
+				fmt.Printf("Reputer stake:      %s\n", info.ReputerStake)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Uint64Var(&topicId, "topic", 0, "topic ID to inspect")
+	_ = cmd.MarkFlagRequired("topic")
+	return cmd
+}
+
+func newTopicFindOpenNoncesCmd() *cobra.Command {
+	var topicId uint64
+	cmd := &cobra.Command{
+		Use:   "find-open-nonces",
+		Short: "List open worker and reputer nonces this node could still submit against",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			suite, err := buildUseCaseSuite()
+			if err != nil {
+				return err
+			}
+
+			workerNonces, err := suite.Node.OpenWorkerNonces(topicId)
+			if err != nil {
+				return fmt.Errorf("could not list open worker nonces for topic %d: %w", topicId, err)
+			}
+			reputerNonces, err := suite.Node.OpenReputerNonces(topicId)
+			if err != nil {
+				return fmt.Errorf("could not list open reputer nonces for topic %d: %w", topicId, err)
+			}
+
+			fmt.Printf("Open worker nonces for topic %d: %v\n", topicId, workerNonces)
+			fmt.Printf("Open reputer nonces for topic %d: %v\n", topicId, reputerNonces)
+			return nil
+		},
+	}
+	cmd.Flags().Uint64Var(&topicId, "topic", 0, "topic ID to list open nonces for")
+	_ = cmd.MarkFlagRequired("topic")
+	return cmd
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"allora_offchain_node/lib"
+	usecase "allora_offchain_node/usecase"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+)
+
+// loadUserConfig reads the node's JSON config from either the env var or
+// the config file env var points at, preferring the env var if both are set.
+// Every subcommand that needs to talk to the chain goes through this, so
+// they all honor the same config sources as `run`.
+func loadUserConfig() (lib.UserConfig, error) {
+	finalUserConfig := lib.UserConfig{} // nolint: exhaustruct
+	alloraJsonConfig := os.Getenv(lib.ALLORA_OFFCHAIN_NODE_CONFIG_JSON)
+	if alloraJsonConfig != "" {
+		log.Info().Msg("Config using JSON env var")
+		// completely reset UserConfig
+		if err := json.Unmarshal([]byte(alloraJsonConfig), &finalUserConfig); err != nil {
+			return lib.UserConfig{}, fmt.Errorf("failed to parse JSON config file from Config: %w", err) // nolint: exhaustruct
+		}
+		return finalUserConfig, nil
+	}
+
+	if os.Getenv(lib.ALLORA_OFFCHAIN_NODE_CONFIG_FILE_PATH) != "" {
+		log.Info().Msg("Config using JSON config file")
+		// parse file defined in CONFIG_FILE_PATH into UserConfig
+		file, err := os.Open(os.Getenv(lib.ALLORA_OFFCHAIN_NODE_CONFIG_FILE_PATH))
+		if err != nil {
+			return lib.UserConfig{}, fmt.Errorf("failed to open JSON config file: %w", err) // nolint: exhaustruct
+		}
+		defer file.Close()
+		decoder := json.NewDecoder(file)
+		// completely reset UserConfig
+		if err := decoder.Decode(&finalUserConfig); err != nil {
+			return lib.UserConfig{}, fmt.Errorf("failed to parse JSON config file: %w", err) // nolint: exhaustruct
+		}
+		return finalUserConfig, nil
+	}
+
+	return lib.UserConfig{}, fmt.Errorf("could not find config file: please create a config.json file and pass as environment variable") // nolint: exhaustruct
+}
+
+// reloadUserConfig re-reads the node's JSON config and applies any changed
+// worker/reputer entrypoints and topic settings to the running spawner,
+// without needing a restart. It is wired up to the admin server's
+// POST /config/reload endpoint.
+func reloadUserConfig(spawner *usecase.UseCaseSuite) error {
+	userConfig, err := loadUserConfig()
+	if err != nil {
+		return fmt.Errorf("failed to reload config: %w", err)
+	}
+	if err := ConvertEntrypointsToInstances(userConfig); err != nil {
+		return fmt.Errorf("failed to convert entrypoints to instances of adapters: %w", err)
+	}
+
+	spawner.Workers = userConfig.Worker
+	spawner.Reputers = userConfig.Reputer
+	log.Info().Int("workers", len(spawner.Workers)).Int("reputers", len(spawner.Reputers)).Msg("Reloaded config")
+	return nil
+}
+
+// buildUseCaseSuite loads the node's config, wires up its adapters, and
+// builds the resulting UseCaseSuite. It's shared by every subcommand that
+// needs a live chain connection (run, register, stake, topic).
+func buildUseCaseSuite() (*usecase.UseCaseSuite, error) {
+	userConfig, err := loadUserConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := ConvertEntrypointsToInstances(userConfig); err != nil {
+		return nil, fmt.Errorf("failed to convert entrypoints to instances of adapters: %w", err)
+	}
+	suite, err := usecase.NewUseCaseSuite(userConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize use case: %w", err)
+	}
+	suite.JournalPath = userConfig.JournalPath
+	return suite, nil
+}
@@ -0,0 +1,67 @@
+package conformance
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+)
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnitReport renders report as a JUnit-style XML file at path, the
+// format CI systems conventionally consume to surface per-vector pass/fail.
+func WriteJUnitReport(report *Report, path string) error {
+	suite := junitTestSuite{ // nolint: exhaustruct
+		Name:      "conformance",
+		Tests:     len(report.Results),
+		Failures:  report.Failed,
+		TestCases: make([]junitTestCase, 0, len(report.Results)),
+	}
+
+	for _, result := range report.Results {
+		testCase := junitTestCase{ // nolint: exhaustruct
+			Name:      result.Vector.Name,
+			ClassName: fmt.Sprintf("%s.%s", result.Vector.Adapter, result.Vector.Method),
+			Time:      result.Duration.Seconds(),
+		}
+		if !result.Passed {
+			testCase.Failure = &junitFailure{Message: result.Message, Text: result.Message}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating JUnit report file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	encoder := xml.NewEncoder(file)
+	encoder.Indent("", "  ")
+	if _, err := file.WriteString(xml.Header); err != nil {
+		return fmt.Errorf("error writing JUnit report header: %w", err)
+	}
+	if err := encoder.Encode(suite); err != nil {
+		return fmt.Errorf("error encoding JUnit report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package conformance
+
+import (
+	"allora_offchain_node/lib"
+	"fmt"
+	"strings"
+	"time"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// AdapterFactory builds a named AlloraAdapter, mirroring the signature of
+// the node's own NewAlloraAdapter factory in main.go so the same adapter
+// construction logic is exercised here as in production.
+type AdapterFactory func(name string) (lib.AlloraAdapter, error)
+
+// Result is the outcome of running a single Vector against an adapter.
+type Result struct {
+	Vector   Vector
+	Passed   bool
+	Message  string
+	Duration time.Duration
+}
+
+// Report summarizes a full conformance run.
+type Report struct {
+	Results []Result
+	Passed  int
+	Failed  int
+}
+
+// Run loads every vector in dir and, for each, constructs the vector's named
+// adapter via factory, invokes the method the vector names, and compares
+// the result (or error) against the vector's expectation. It returns a
+// Report covering every vector; a non-nil error is only returned for
+// failures to load the corpus itself.
+func Run(dir string, factory AdapterFactory) (*Report, error) {
+	vectors, err := LoadVectors(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Results: make([]Result, 0, len(vectors))} // nolint: exhaustruct
+	for _, v := range vectors {
+		result := runVector(v, factory)
+		report.Results = append(report.Results, result)
+		if result.Passed {
+			report.Passed++
+		} else {
+			report.Failed++
+		}
+	}
+
+	return report, nil
+}
+
+func runVector(v Vector, factory AdapterFactory) Result {
+	start := time.Now()
+	result := Result{Vector: v} // nolint: exhaustruct
+
+	adapter, err := factory(v.Adapter)
+	if err != nil {
+		result.Message = fmt.Sprintf("error constructing adapter %q: %v", v.Adapter, err)
+		result.Duration = time.Since(start)
+		return result
+	}
+
+	output, err := invoke(adapter, v)
+	result.Duration = time.Since(start)
+
+	if v.ExpectedErrorContains != "" {
+		if err == nil {
+			result.Message = fmt.Sprintf("expected error containing %q, got success with output %q", v.ExpectedErrorContains, output)
+			return result
+		}
+		if !strings.Contains(err.Error(), v.ExpectedErrorContains) {
+			result.Message = fmt.Sprintf("expected error containing %q, got %q", v.ExpectedErrorContains, err.Error())
+			return result
+		}
+		result.Passed = true
+		return result
+	}
+
+	if err != nil {
+		result.Message = fmt.Sprintf("unexpected error: %v", err)
+		return result
+	}
+
+	ok, message := compareWithTolerance(v.ExpectedOutput, output, v.Tolerance)
+	result.Passed = ok
+	result.Message = message
+	return result
+}
+
+func invoke(adapter lib.AlloraAdapter, v Vector) (string, error) {
+	switch v.Method {
+	case "Inference":
+		return adapter.Inference(lib.WorkerConfig{TopicId: v.TopicId}, v.BlockHeight) // nolint: exhaustruct
+	case "Forecast":
+		return adapter.Forecast(lib.WorkerConfig{TopicId: v.TopicId}, v.BlockHeight, v.Inputs["infererValue"]) // nolint: exhaustruct
+	case "TruthSourceValue":
+		return adapter.TruthSourceValue(lib.ReputerConfig{TopicId: v.TopicId}, v.BlockHeight) // nolint: exhaustruct
+	case "LossFunction":
+		reputerConfig := lib.ReputerConfig{TopicId: v.TopicId} // nolint: exhaustruct
+		return adapter.LossFunction(reputerConfig, v.Inputs["sourceTruth"], v.Inputs["value"], v.Options)
+	default:
+		return "", fmt.Errorf("unknown conformance vector method %q", v.Method)
+	}
+}
+
+// compareWithTolerance compares expected and actual as alloraMath.Dec
+// values. An empty tolerance requires an exact string match; otherwise the
+// two values must differ by no more than tolerance.
+func compareWithTolerance(expected, actual, tolerance string) (bool, string) {
+	if tolerance == "" {
+		if expected == actual {
+			return true, ""
+		}
+		return false, fmt.Sprintf("expected %q, got %q", expected, actual)
+	}
+
+	expectedDec, err := alloraMath.NewDecFromString(expected)
+	if err != nil {
+		return false, fmt.Sprintf("error parsing expected_output %q: %v", expected, err)
+	}
+	actualDec, err := alloraMath.NewDecFromString(actual)
+	if err != nil {
+		return false, fmt.Sprintf("error parsing actual output %q: %v", actual, err)
+	}
+	toleranceDec, err := alloraMath.NewDecFromString(tolerance)
+	if err != nil {
+		return false, fmt.Sprintf("error parsing tolerance %q: %v", tolerance, err)
+	}
+
+	diff := expectedDec.Sub(actualDec).Abs()
+	if diff.Lte(toleranceDec) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("expected %q, got %q, outside tolerance %q", expected, actual, tolerance)
+}
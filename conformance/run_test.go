@@ -0,0 +1,102 @@
+package conformance
+
+import (
+	"allora_offchain_node/lib"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// sqeAdapter is a minimal stand-in for a real adapter, implementing just
+// enough of lib.AlloraAdapter to exercise the starter corpus: squared-error
+// loss, which is intrinsically non-negative, plus a parse error on
+// non-numeric input.
+type sqeAdapter struct{}
+
+func (sqeAdapter) Inference(config lib.WorkerConfig, blockHeight int64) (string, error) {
+	return "", fmt.Errorf("sqeAdapter does not implement Inference")
+}
+
+func (sqeAdapter) Forecast(config lib.WorkerConfig, blockHeight int64, infererValue string) (string, error) {
+	return "", fmt.Errorf("sqeAdapter does not implement Forecast")
+}
+
+func (sqeAdapter) TruthSourceValue(config lib.ReputerConfig, blockHeight int64) (string, error) {
+	return "", fmt.Errorf("sqeAdapter does not implement TruthSourceValue")
+}
+
+func (sqeAdapter) LossFunction(config lib.ReputerConfig, sourceTruth string, value string, options map[string]string) (string, error) {
+	truth, err := strconv.ParseFloat(sourceTruth, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid sourceTruth %q: %w", sourceTruth, err)
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid value %q: %w", value, err)
+	}
+	diff := truth - v
+	return fmt.Sprintf("%.4f", diff*diff), nil
+}
+
+func fakeAdapterFactory(name string) (lib.AlloraAdapter, error) {
+	if name != "mock" {
+		return nil, fmt.Errorf("unknown conformance test adapter %q", name)
+	}
+	return sqeAdapter{}, nil
+}
+
+func TestRunStarterCorpus(t *testing.T) {
+	report, err := Run("testdata", fakeAdapterFactory)
+	require.NoError(t, err)
+	require.Len(t, report.Results, 3)
+
+	for _, result := range report.Results {
+		if !result.Passed {
+			t.Errorf("vector %q failed: %s", result.Vector.Name, result.Message)
+		}
+	}
+	require.Equal(t, 3, report.Passed)
+	require.Equal(t, 0, report.Failed)
+}
+
+func TestRunReportsFailureWithoutMatchingExpectation(t *testing.T) {
+	dir := t.TempDir()
+	vector := `{
+  "name": "loss_function_wrong_expectation",
+  "adapter": "mock",
+  "method": "LossFunction",
+  "topicId": 1,
+  "inputs": {
+    "sourceTruth": "10.0",
+    "value": "9.5"
+  },
+  "options": {
+    "method": "sqe"
+  },
+  "expected_output": "999.0",
+  "tolerance": "0.0001"
+}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "loss_function_wrong_expectation.json"), []byte(vector), 0o600))
+
+	report, err := Run(dir, fakeAdapterFactory)
+	require.NoError(t, err)
+	require.Len(t, report.Results, 1)
+
+	result := report.Results[0]
+	require.False(t, result.Passed)
+	require.Equal(t, 0, report.Passed)
+	require.Equal(t, 1, report.Failed)
+	require.Contains(t, result.Message, "999.0")
+	require.Contains(t, result.Message, "0.25")
+}
+
+func TestCompareWithToleranceExactMatchRequiredWhenNoTolerance(t *testing.T) {
+	ok, msg := compareWithTolerance("0.25", "0.2500", "")
+	require.False(t, ok)
+	require.True(t, strings.Contains(msg, "expected"))
+}
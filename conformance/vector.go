@@ -0,0 +1,62 @@
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Vector is a single test-vector file describing one call into an
+// AlloraAdapter and the output (or error) a conformant implementation is
+// expected to produce.
+type Vector struct {
+	Name                  string            `json:"name"`
+	Adapter               string            `json:"adapter"`
+	Method                string            `json:"method"` // "Inference" | "Forecast" | "TruthSourceValue" | "LossFunction"
+	TopicId               uint64            `json:"topicId"`
+	BlockHeight           int64             `json:"blockHeight"`
+	Inputs                map[string]string `json:"inputs"`
+	Options               map[string]string `json:"options"`
+	ExpectedOutput        string            `json:"expected_output"`
+	ExpectedErrorContains string            `json:"expected_error_contains"`
+	Tolerance             string            `json:"tolerance"`
+}
+
+// LoadVectors reads every *.json file in dir and decodes it as a Vector,
+// sorted by file name so a run's ordering is stable and reproducible.
+func LoadVectors(dir string) ([]Vector, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading conformance vector directory %s: %w", dir, err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	vectors := make([]Vector, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading conformance vector %s: %w", path, err)
+		}
+		var v Vector
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("error parsing conformance vector %s: %w", path, err)
+		}
+		if v.Name == "" {
+			v.Name = name
+		}
+		vectors = append(vectors, v)
+	}
+
+	return vectors, nil
+}
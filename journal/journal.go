@@ -0,0 +1,177 @@
+// Package journal implements a crash-restartable, on-disk record of
+// in-flight worker/reputer submissions, so the spawner can resume after a
+// restart without resubmitting work that already landed on chain or
+// silently dropping work that didn't.
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Status is a lifecycle stage for one topic/nonce submission attempt.
+type Status string
+
+const (
+	StatusQueued        Status = "queued"
+	StatusAdapterCalled Status = "adapter_called"
+	StatusTxBroadcast   Status = "tx_broadcast"
+	StatusTxConfirmed   Status = "tx_confirmed"
+	StatusTxFailed      Status = "tx_failed"
+)
+
+// Entry is one journaled submission attempt, identified by its topic, role,
+// and nonce.
+type Entry struct {
+	TopicId     uint64 `json:"topicId"`
+	Nonce       int64  `json:"nonce"`
+	Role        string `json:"role"` // "worker" or "reputer"
+	Status      Status `json:"status"`
+	TxHash      string `json:"txHash,omitempty"`
+	BlockHeight int64  `json:"blockHeight"` // block height the entry was recorded against (the spawner records the nonce's own height)
+	UpdatedUnix int64  `json:"updatedUnix"`
+}
+
+var journalBucket = []byte("journal")
+
+// openTimeout bounds how long Open/OpenReadOnly wait to acquire the
+// journal file's lock, so a second process (e.g. `journal dump` run
+// against a live node) fails clearly instead of blocking indefinitely.
+const openTimeout = 5 * time.Second
+
+// Journal is a BoltDB-backed store of Entry records.
+type Journal struct {
+	db *bolt.DB
+}
+
+// Open opens (creating if necessary) the journal file at path for reading
+// and writing. It is meant for the node itself, which holds the journal
+// open for its whole lifetime; use OpenReadOnly for one-off inspection of a
+// journal another process may already have open.
+func Open(path string) (*Journal, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: openTimeout}) // nolint: exhaustruct
+	if err != nil {
+		return nil, fmt.Errorf("could not open journal at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(journalBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize journal bucket in %s: %w", path, err)
+	}
+
+	return &Journal{db: db}, nil
+}
+
+// OpenReadOnly opens the journal file at path for reading only. It takes a
+// shared file lock rather than the exclusive one Open takes, so several
+// read-only openers (e.g. `journal dump`) can coexist; while a node holds the
+// journal open via Open, it waits up to openTimeout and then fails. Because a read-only
+// transaction can't create the journal bucket, All() tolerates the bucket
+// not existing yet.
+func OpenReadOnly(path string) (*Journal, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{ReadOnly: true, Timeout: openTimeout}) // nolint: exhaustruct
+	if err != nil {
+		return nil, fmt.Errorf("could not open journal at %s: %w", path, err)
+	}
+	return &Journal{db: db}, nil
+}
+
+// Close closes the underlying journal file.
+func (j *Journal) Close() error {
+	return j.db.Close()
+}
+
+func entryKey(topicId uint64, nonce int64, role string) []byte {
+	return []byte(fmt.Sprintf("%020d:%s:%020d", topicId, role, nonce))
+}
+
+// Record upserts entry, keyed by its topic, role, and nonce, stamping
+// UpdatedUnix to now.
+func (j *Journal) Record(entry Entry) error {
+	entry.UpdatedUnix = time.Now().Unix()
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("could not marshal journal entry for topic %d nonce %d: %w", entry.TopicId, entry.Nonce, err)
+	}
+	return j.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(journalBucket).Put(entryKey(entry.TopicId, entry.Nonce, entry.Role), data)
+	})
+}
+
+// All returns every entry currently in the journal, in key order (topic,
+// then role, then nonce). The bucket not existing (e.g. a fresh file opened
+// read-only before anything was ever recorded into it) is not an error; it
+// simply yields no entries.
+func (j *Journal) All() ([]Entry, error) {
+	var entries []Entry
+	err := j.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(journalBucket)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(_, v []byte) error {
+			var entry Entry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return fmt.Errorf("could not unmarshal journal entry: %w", err)
+			}
+			entries = append(entries, entry)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Delete removes the entry for the given topic/role/nonce - e.g. once its
+// topic's epoch has passed and the entry is no longer actionable.
+func (j *Journal) Delete(topicId uint64, nonce int64, role string) error {
+	return j.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(journalBucket).Delete(entryKey(topicId, nonce, role))
+	})
+}
+
+// ExpireBefore deletes every entry last updated before cutoff, whatever its
+// topic or status, and returns how many entries it deleted.
+func (j *Journal) ExpireBefore(cutoffUnix int64) (int, error) {
+	var toDelete [][]byte
+	err := j.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(journalBucket).ForEach(func(k, v []byte) error {
+			var entry Entry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return fmt.Errorf("could not unmarshal journal entry: %w", err)
+			}
+			if entry.UpdatedUnix < cutoffUnix {
+				key := make([]byte, len(k))
+				copy(key, k)
+				toDelete = append(toDelete, key)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = j.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(journalBucket)
+		for _, key := range toDelete {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(toDelete), nil
+}
@@ -0,0 +1,104 @@
+package journal
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestRecordAllDeleteRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.db")
+	j, err := Open(path)
+	require.NoError(t, err)
+	defer j.Close()
+
+	require.NoError(t, j.Record(Entry{TopicId: 1, Nonce: 10, Role: "worker", Status: StatusQueued})) // nolint: exhaustruct
+	require.NoError(t, j.Record(Entry{TopicId: 1, Nonce: 20, Role: "worker", Status: StatusQueued})) // nolint: exhaustruct
+
+	entries, err := j.All()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	require.NoError(t, j.Delete(1, 10, "worker"))
+	entries, err = j.All()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.EqualValues(t, 20, entries[0].Nonce)
+}
+
+func TestRecordUpsertsByTopicRoleNonce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.db")
+	j, err := Open(path)
+	require.NoError(t, err)
+	defer j.Close()
+
+	require.NoError(t, j.Record(Entry{TopicId: 1, Nonce: 10, Role: "worker", Status: StatusQueued}))      // nolint: exhaustruct
+	require.NoError(t, j.Record(Entry{TopicId: 1, Nonce: 10, Role: "worker", Status: StatusTxConfirmed})) // nolint: exhaustruct
+
+	entries, err := j.All()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, StatusTxConfirmed, entries[0].Status)
+}
+
+func TestExpireBeforeDeletesOnlyStaleEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.db")
+	j, err := Open(path)
+	require.NoError(t, err)
+	defer j.Close()
+
+	require.NoError(t, j.Record(Entry{TopicId: 1, Nonce: 1, Role: "worker", Status: StatusTxConfirmed})) // nolint: exhaustruct
+	entries, err := j.All()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	staleUpdated := entries[0].UpdatedUnix
+
+	// UpdatedUnix has one-second resolution: wait until the next second so
+	// the second entry is stamped at or after the cutoff below.
+	time.Sleep(time.Until(time.Unix(staleUpdated+1, 0)))
+	require.NoError(t, j.Record(Entry{TopicId: 2, Nonce: 1, Role: "worker", Status: StatusTxConfirmed})) // nolint: exhaustruct
+
+	count, err := j.ExpireBefore(staleUpdated + 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	entries, err = j.All()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.EqualValues(t, 2, entries[0].TopicId)
+}
+
+func TestOpenReadOnlyReadsWhatWriterRecorded(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.db")
+	writer, err := Open(path)
+	require.NoError(t, err)
+	require.NoError(t, writer.Record(Entry{TopicId: 1, Nonce: 1, Role: "worker", Status: StatusQueued})) // nolint: exhaustruct
+	require.NoError(t, writer.Close())
+
+	reader, err := OpenReadOnly(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	entries, err := reader.All()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestOpenReadOnlyToleratesMissingBucket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.db")
+	// A bare bolt file, without the journal bucket Open would create.
+	db, err := bolt.Open(path, 0o600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	reader, err := OpenReadOnly(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	entries, err := reader.All()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
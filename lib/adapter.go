@@ -0,0 +1,43 @@
+package lib
+
+// AlloraAdapter is implemented by the model/reputer runtimes (in-process or
+// reached over RPC) that a topic's entrypoints are wired up to. The offchain
+// node calls into it for every inference, forecast, ground truth, and loss
+// computation it needs to submit on a worker's or reputer's behalf.
+type AlloraAdapter interface {
+	Inference(config WorkerConfig, blockHeight int64) (string, error)
+	Forecast(config WorkerConfig, blockHeight int64, infererValue string) (string, error)
+	TruthSourceValue(config ReputerConfig, blockHeight int64) (string, error)
+	LossFunction(config ReputerConfig, sourceTruth string, value string, options map[string]string) (string, error)
+}
+
+// WorkerConfig holds the entrypoints and topic metadata for a single worker registration.
+type WorkerConfig struct {
+	TopicId                 uint64
+	InferenceEntrypointName string
+	InferenceEntrypoint     AlloraAdapter
+	ForecastEntrypointName  string
+	ForecastEntrypoint      AlloraAdapter
+}
+
+// LossFunctionParameters configures how a reputer's LossFunction entrypoint is invoked.
+type LossFunctionParameters struct {
+	LossMethodOptions map[string]string
+	IsNeverNegative   *bool
+}
+
+// ReputerConfig holds the entrypoints, topic metadata, and loss-function
+// parameters for a single reputer registration.
+type ReputerConfig struct {
+	TopicId                    uint64
+	MinStake                   int64
+	GroundTruthEntrypointName  string
+	GroundTruthEntrypoint      AlloraAdapter
+	LossFunctionEntrypointName string
+	LossFunctionEntrypoint     AlloraAdapter
+	LossFunctionParameters     LossFunctionParameters
+	// LossComputeConcurrency bounds how many LossFunction calls
+	// ComputeLossBundle dispatches to its worker pool at once. Values <= 1
+	// preserve the historical serial, one-call-at-a-time behavior.
+	LossComputeConcurrency int
+}
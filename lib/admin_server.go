@@ -0,0 +1,185 @@
+package lib
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// AdminConfig controls the optional admin HTTP server. It is off by default;
+// operators opt in by setting Port and BearerToken.
+type AdminConfig struct {
+	Enabled     bool
+	Port        string
+	BearerToken string
+}
+
+// TopicStatus is the registration/stake/submission state of a single topic,
+// as reported through the admin server's /status endpoint.
+type TopicStatus struct {
+	TopicId    uint64 `json:"topicId"`
+	Role       string `json:"role"` // "worker" or "reputer"
+	Registered bool   `json:"registered"`
+	Stake      string `json:"stake,omitempty"`
+	LastNonce  int64  `json:"lastNonce"`
+	LastError  string `json:"lastError,omitempty"`
+}
+
+// AdminStatusProvider is implemented by usecase.UseCaseSuite to give the
+// admin server a way to inspect and act on live node state without
+// restarting the process.
+type AdminStatusProvider interface {
+	TopicStatuses() []TopicStatus
+	RegisterTopic(topicId uint64) error
+	AddStake(topicId uint64, amount int64) error
+	RemoveStake(topicId uint64, amount int64) error
+	ReloadConfig() error
+}
+
+// AdminServer exposes runtime introspection and re-registration endpoints
+// alongside the node's Prometheus metrics server, for operators who need to
+// react to on-chain changes (e.g. a raised RegistrationFee) or newly added
+// topics without restarting the process.
+type AdminServer struct {
+	config   AdminConfig
+	provider AdminStatusProvider
+	server   *http.Server
+}
+
+// NewAdminServer builds an AdminServer backed by provider. It does not start
+// listening until Start is called.
+func NewAdminServer(config AdminConfig, provider AdminStatusProvider) *AdminServer {
+	return &AdminServer{config: config, provider: provider} // nolint: exhaustruct
+}
+
+// Start begins serving the admin API in a background goroutine. It is a
+// no-op if config.Enabled is false.
+func (a *AdminServer) Start() {
+	if !a.config.Enabled {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", a.authenticated(a.handleStatus))
+	mux.HandleFunc("/topics/", a.authenticated(a.handleTopics))
+	mux.HandleFunc("/config/reload", a.authenticated(a.handleConfigReload))
+
+	a.server = &http.Server{ // nolint: exhaustruct
+		Addr:    a.config.Port,
+		Handler: mux,
+	}
+
+	go func() {
+		log.Info().Str("port", a.config.Port).Msg("Starting admin server")
+		if err := a.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Admin server stopped unexpectedly")
+		}
+	}()
+}
+
+// Stop gracefully shuts down the admin server, if it was started.
+func (a *AdminServer) Stop(ctx context.Context) error {
+	if a.server == nil {
+		return nil
+	}
+	return a.server.Shutdown(ctx)
+}
+
+func (a *AdminServer) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(a.config.BearerToken)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (a *AdminServer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, http.StatusOK, a.provider.TopicStatuses())
+}
+
+// handleTopics dispatches POST /topics/{id}/register, /stake, and /unstake.
+func (a *AdminServer) handleTopics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/topics/"), "/"), "/")
+	if len(parts) != 2 {
+		http.Error(w, "expected /topics/{id}/register|stake|unstake", http.StatusBadRequest)
+		return
+	}
+	topicId, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid topic id %q", parts[0]), http.StatusBadRequest)
+		return
+	}
+
+	switch parts[1] {
+	case "register":
+		if err := a.provider.RegisterTopic(topicId); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	case "stake":
+		var body struct {
+			Amount int64 `json:"amount"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := a.provider.AddStake(topicId, body.Amount); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	case "unstake":
+		var body struct {
+			Amount int64 `json:"amount"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := a.provider.RemoveStake(topicId, body.Amount); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	default:
+		http.Error(w, fmt.Sprintf("unknown topic action %q", parts[1]), http.StatusNotFound)
+	}
+}
+
+func (a *AdminServer) handleConfigReload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := a.provider.ReloadConfig(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,156 @@
+package lib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAdminStatusProvider is a minimal in-memory AdminStatusProvider used to
+// exercise the admin server's routing and auth without a live chain
+// connection.
+type fakeAdminStatusProvider struct {
+	statuses       []TopicStatus
+	registerCalls  []uint64
+	addStakeCalls  map[uint64]int64
+	removeStakeErr error
+	reloadCalled   bool
+	reloadErr      error
+}
+
+func (f *fakeAdminStatusProvider) TopicStatuses() []TopicStatus {
+	return f.statuses
+}
+
+func (f *fakeAdminStatusProvider) RegisterTopic(topicId uint64) error {
+	f.registerCalls = append(f.registerCalls, topicId)
+	return nil
+}
+
+func (f *fakeAdminStatusProvider) AddStake(topicId uint64, amount int64) error {
+	f.addStakeCalls[topicId] = amount
+	return nil
+}
+
+func (f *fakeAdminStatusProvider) RemoveStake(topicId uint64, amount int64) error {
+	return f.removeStakeErr
+}
+
+func (f *fakeAdminStatusProvider) ReloadConfig() error {
+	f.reloadCalled = true
+	return f.reloadErr
+}
+
+func newTestAdminServer() (*AdminServer, *fakeAdminStatusProvider) {
+	provider := &fakeAdminStatusProvider{ // nolint: exhaustruct
+		statuses:      []TopicStatus{{TopicId: 1, Role: "worker", Registered: true, LastNonce: 42}}, // nolint: exhaustruct
+		addStakeCalls: map[uint64]int64{},
+	}
+	config := AdminConfig{Enabled: true, Port: ":0", BearerToken: "s3cret"}
+	return NewAdminServer(config, provider), provider
+}
+
+func doRequest(t *testing.T, server *AdminServer, method, path, token string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+
+	switch {
+	case path == "/status":
+		server.authenticated(server.handleStatus)(rec, req)
+	case path == "/config/reload":
+		server.authenticated(server.handleConfigReload)(rec, req)
+	default:
+		server.authenticated(server.handleTopics)(rec, req)
+	}
+	return rec
+}
+
+func TestAdminServerRejectsMissingOrWrongToken(t *testing.T) {
+	server, _ := newTestAdminServer()
+
+	rec := doRequest(t, server, http.MethodGet, "/status", "", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = doRequest(t, server, http.MethodGet, "/status", "wrong", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestAdminServerHandleStatus(t *testing.T) {
+	server, _ := newTestAdminServer()
+
+	rec := doRequest(t, server, http.MethodGet, "/status", "s3cret", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var statuses []TopicStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &statuses))
+	require.Len(t, statuses, 1)
+	require.EqualValues(t, 42, statuses[0].LastNonce)
+
+	rec = doRequest(t, server, http.MethodPost, "/status", "s3cret", nil)
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestAdminServerHandleTopicsRegister(t *testing.T) {
+	server, provider := newTestAdminServer()
+
+	rec := doRequest(t, server, http.MethodPost, "/topics/7/register", "s3cret", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, []uint64{7}, provider.registerCalls)
+}
+
+func TestAdminServerHandleTopicsStake(t *testing.T) {
+	server, provider := newTestAdminServer()
+
+	body, err := json.Marshal(map[string]int64{"amount": 100})
+	require.NoError(t, err)
+
+	rec := doRequest(t, server, http.MethodPost, "/topics/7/stake", "s3cret", body)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, int64(100), provider.addStakeCalls[7])
+}
+
+func TestAdminServerHandleTopicsStakeInvalidBody(t *testing.T) {
+	server, _ := newTestAdminServer()
+
+	rec := doRequest(t, server, http.MethodPost, "/topics/7/stake", "s3cret", []byte("not-json"))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAdminServerHandleTopicsInvalidTopicId(t *testing.T) {
+	server, _ := newTestAdminServer()
+
+	rec := doRequest(t, server, http.MethodPost, "/topics/not-a-number/register", "s3cret", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAdminServerHandleTopicsUnknownAction(t *testing.T) {
+	server, _ := newTestAdminServer()
+
+	rec := doRequest(t, server, http.MethodPost, "/topics/7/frobnicate", "s3cret", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAdminServerHandleConfigReload(t *testing.T) {
+	server, provider := newTestAdminServer()
+
+	rec := doRequest(t, server, http.MethodPost, "/config/reload", "s3cret", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, provider.reloadCalled)
+}
+
+func TestAdminServerHandleConfigReloadPropagatesError(t *testing.T) {
+	server, provider := newTestAdminServer()
+	provider.reloadErr = fmt.Errorf("reload failed")
+
+	rec := doRequest(t, server, http.MethodPost, "/config/reload", "s3cret", nil)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
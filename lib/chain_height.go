@@ -0,0 +1,13 @@
+package lib
+
+import "context"
+
+// GetCurrentBlockHeight returns the latest block height known to the node's
+// chain client, used to judge how stale in-flight journal entries are.
+func (node *NodeConfig) GetCurrentBlockHeight() (int64, error) {
+	status, err := node.Chain.Client.Status(context.Background())
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
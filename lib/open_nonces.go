@@ -0,0 +1,62 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// OpenWorkerNonces returns the block heights of worker nonces topicId still
+// has open (i.e. not yet fulfilled) on-chain.
+func (node *NodeConfig) OpenWorkerNonces(topicId uint64) ([]int64, error) {
+	ctx := context.Background()
+	res, err := node.Chain.EmissionsQueryClient.GetUnfulfilledWorkerNonces(ctx, &emissionstypes.QueryUnfulfilledWorkerNoncesRequest{TopicId: topicId})
+	if err != nil {
+		return nil, fmt.Errorf("could not query unfulfilled worker nonces for topic %d: %w", topicId, err)
+	}
+
+	nonces := make([]int64, 0, len(res.Nonces.Nonces))
+	for _, nonce := range res.Nonces.Nonces {
+		nonces = append(nonces, nonce.BlockHeight)
+	}
+	return nonces, nil
+}
+
+// OpenReputerNonces returns the block heights of reputer nonces topicId
+// still has open (i.e. not yet fulfilled) on-chain.
+func (node *NodeConfig) OpenReputerNonces(topicId uint64) ([]int64, error) {
+	ctx := context.Background()
+	res, err := node.Chain.EmissionsQueryClient.GetUnfulfilledReputerNonces(ctx, &emissionstypes.QueryUnfulfilledReputerNoncesRequest{TopicId: topicId})
+	if err != nil {
+		return nil, fmt.Errorf("could not query unfulfilled reputer nonces for topic %d: %w", topicId, err)
+	}
+
+	nonces := make([]int64, 0, len(res.Nonces.Nonces))
+	for _, nonce := range res.Nonces.Nonces {
+		nonces = append(nonces, nonce.ReputerNonce.BlockHeight)
+	}
+	return nonces, nil
+}
+
+// IsNonceOpen reports whether nonce is still open (unfulfilled) on-chain for
+// topicId, in the given role.
+func (node *NodeConfig) IsNonceOpen(topicId uint64, nonce int64, isReputer bool) (bool, error) {
+	var open []int64
+	var err error
+	if isReputer {
+		open, err = node.OpenReputerNonces(topicId)
+	} else {
+		open, err = node.OpenWorkerNonces(topicId)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, n := range open {
+		if n == nonce {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,49 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	cosmossdk_io_math "cosmossdk.io/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// AddStake stakes amount (in uallo) on top of whatever the node already has
+// staked in topicId, beyond whatever RegisterAndStakeReputerIdempotently
+// already brought it up to.
+func (node *NodeConfig) AddStake(topicId uint64, amount int64) error {
+	ctx := context.Background()
+	msg := &emissionstypes.AddStakeRequest{
+		Sender:  node.Wallet.Address,
+		Amount:  cosmossdk_io_math.NewInt(amount),
+		TopicId: topicId,
+	}
+	res, err := node.SendDataWithRetry(ctx, msg, "Add stake")
+	if err != nil {
+		txHash := ""
+		if res != nil {
+			txHash = res.TxHash
+		}
+		return fmt.Errorf("could not add stake in topic %d (txHash %s): %w", topicId, txHash, err)
+	}
+	return nil
+}
+
+// RemoveStake withdraws amount (in uallo) of the node's stake from topicId.
+func (node *NodeConfig) RemoveStake(topicId uint64, amount int64) error {
+	ctx := context.Background()
+	msg := &emissionstypes.RemoveStakeRequest{
+		Sender:  node.Wallet.Address,
+		Amount:  cosmossdk_io_math.NewInt(amount),
+		TopicId: topicId,
+	}
+	res, err := node.SendDataWithRetry(ctx, msg, "Remove stake")
+	if err != nil {
+		txHash := ""
+		if res != nil {
+			txHash = res.TxHash
+		}
+		return fmt.Errorf("could not remove stake in topic %d (txHash %s): %w", topicId, txHash, err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// SubmitWorkerPayload broadcasts a worker's inference (and, if non-empty,
+// forecast) for the given nonce, returning the resulting tx hash.
+func (node *NodeConfig) SubmitWorkerPayload(topicId uint64, nonce int64, inferenceValue string, forecastValue string) (string, error) {
+	ctx := context.Background()
+
+	inferenceDec, err := alloraMath.NewDecFromString(inferenceValue)
+	if err != nil {
+		return "", fmt.Errorf("could not parse inference value for topic %d nonce %d: %w", topicId, nonce, err)
+	}
+
+	bundle := &emissionstypes.InferenceForecastBundle{ // nolint: exhaustruct
+		Inference: &emissionstypes.Inference{
+			TopicId:     topicId,
+			BlockHeight: nonce,
+			Inferer:     node.Wallet.Address,
+			Value:       inferenceDec,
+		},
+	}
+	if forecastValue != "" {
+		forecastDec, err := alloraMath.NewDecFromString(forecastValue)
+		if err != nil {
+			return "", fmt.Errorf("could not parse forecast value for topic %d nonce %d: %w", topicId, nonce, err)
+		}
+		bundle.Forecast = &emissionstypes.Forecast{ // nolint: exhaustruct
+			TopicId:     topicId,
+			BlockHeight: nonce,
+			Forecaster:  node.Wallet.Address,
+			ForecastElements: []*emissionstypes.ForecastElement{
+				{Inferer: node.Wallet.Address, Value: forecastDec},
+			},
+		}
+	}
+
+	msg := &emissionstypes.InsertWorkerPayloadRequest{
+		Sender: node.Wallet.Address,
+		WorkerDataBundle: &emissionstypes.WorkerDataBundle{ // nolint: exhaustruct
+			Worker:                   node.Wallet.Address,
+			TopicId:                  topicId,
+			Nonce:                    &emissionstypes.Nonce{BlockHeight: nonce},
+			InferenceForecastsBundle: bundle,
+		},
+	}
+	res, err := node.SendDataWithRetry(ctx, msg, "Submit worker payload")
+	if err != nil {
+		txHash := ""
+		if res != nil {
+			txHash = res.TxHash
+		}
+		return txHash, fmt.Errorf("could not submit worker payload for topic %d nonce %d (txHash %s): %w", topicId, nonce, txHash, err)
+	}
+	return res.TxHash, nil
+}
+
+// SubmitReputerPayload broadcasts a reputer's loss bundle for the given
+// nonce, returning the resulting tx hash.
+func (node *NodeConfig) SubmitReputerPayload(topicId uint64, nonce int64, valueBundle *emissionstypes.ValueBundle) (string, error) {
+	ctx := context.Background()
+
+	msg := &emissionstypes.InsertReputerPayloadRequest{
+		Sender: node.Wallet.Address,
+		ReputerValueBundle: &emissionstypes.ReputerValueBundle{ // nolint: exhaustruct
+			ValueBundle: valueBundle,
+		},
+	}
+	res, err := node.SendDataWithRetry(ctx, msg, "Submit reputer payload")
+	if err != nil {
+		txHash := ""
+		if res != nil {
+			txHash = res.TxHash
+		}
+		return txHash, fmt.Errorf("could not submit reputer payload for topic %d nonce %d (txHash %s): %w", topicId, nonce, txHash, err)
+	}
+	return res.TxHash, nil
+}
+
+// GetNetworkValueBundle fetches the network's current combined/naive/
+// per-worker inference values for topicId at nonce, which a reputer needs
+// as the basis for ComputeLossBundle.
+func (node *NodeConfig) GetNetworkValueBundle(topicId uint64, nonce int64) (*emissionstypes.ValueBundle, error) {
+	ctx := context.Background()
+	res, err := node.Chain.EmissionsQueryClient.GetNetworkInferencesAtBlock(ctx, &emissionstypes.QueryNetworkInferencesAtBlockRequest{
+		TopicId:     topicId,
+		BlockHeight: nonce,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not query network inferences for topic %d nonce %d: %w", topicId, nonce, err)
+	}
+	return res.NetworkInferences, nil
+}
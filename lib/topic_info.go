@@ -0,0 +1,70 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// TopicInfo is a human-friendly snapshot of a topic's epoch schedule and
+// this node's standing within it, used by the `topic info` CLI subcommand.
+type TopicInfo struct {
+	TopicId            uint64
+	EpochLength        int64
+	CurrentBlockHeight int64
+	NextEpochEnd       int64
+	WorkerRegistered   bool
+	ReputerRegistered  bool
+	ReputerStake       string
+}
+
+// GetTopicInfo gathers a topic's epoch schedule alongside this node's
+// worker/reputer registration and stake status in that topic.
+func (node *NodeConfig) GetTopicInfo(topicId uint64) (TopicInfo, error) {
+	ctx := context.Background()
+
+	topicRes, err := node.Chain.EmissionsQueryClient.GetTopic(ctx, &emissionstypes.QueryTopicRequest{TopicId: topicId})
+	if err != nil {
+		return TopicInfo{}, fmt.Errorf("could not query topic %d: %w", topicId, err) // nolint: exhaustruct
+	}
+
+	currentBlockHeight, err := node.GetCurrentBlockHeight()
+	if err != nil {
+		return TopicInfo{}, fmt.Errorf("could not get current block height for topic %d: %w", topicId, err) // nolint: exhaustruct
+	}
+
+	epochLength := topicRes.Topic.EpochLength
+	var nextEpochEnd int64
+	if epochLength > 0 {
+		nextEpochEnd = currentBlockHeight + (epochLength - currentBlockHeight%epochLength)
+	}
+
+	workerRegistered, err := node.IsWorkerRegistered(topicId)
+	if err != nil {
+		return TopicInfo{}, fmt.Errorf("could not check worker registration for topic %d: %w", topicId, err) // nolint: exhaustruct
+	}
+	reputerRegistered, err := node.IsReputerRegistered(topicId)
+	if err != nil {
+		return TopicInfo{}, fmt.Errorf("could not check reputer registration for topic %d: %w", topicId, err) // nolint: exhaustruct
+	}
+
+	info := TopicInfo{
+		TopicId:            topicId,
+		EpochLength:        epochLength,
+		CurrentBlockHeight: currentBlockHeight,
+		NextEpochEnd:       nextEpochEnd,
+		WorkerRegistered:   workerRegistered,
+		ReputerRegistered:  reputerRegistered,
+	}
+
+	if reputerRegistered {
+		stake, err := node.GetReputerStakeInTopic(topicId, node.Chain.Address)
+		if err != nil {
+			return TopicInfo{}, fmt.Errorf("could not get reputer stake for topic %d: %w", topicId, err) // nolint: exhaustruct
+		}
+		info.ReputerStake = stake.String()
+	}
+
+	return info, nil
+}
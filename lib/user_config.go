@@ -0,0 +1,13 @@
+package lib
+
+// UserConfig is the node's top-level JSON configuration: the worker and
+// reputer registrations it should serve, and the on-disk paths it reads
+// from or writes to outside of stdout logging.
+type UserConfig struct {
+	Worker  []WorkerConfig
+	Reputer []ReputerConfig
+
+	// JournalPath is where the node's crash-restartable submission journal
+	// is kept. Empty disables the journal entirely.
+	JournalPath string
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"allora_offchain_node/conformance"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// newConformanceCmd implements the `conformance` subcommand: it runs a
+// directory of test vectors against the same NewAlloraAdapter factory the
+// node itself uses, so third-party adapter authors get a reproducible
+// compliance target without needing a chain.
+func newConformanceCmd() *cobra.Command {
+	var vectorsDir string
+	var reportPath string
+
+	cmd := &cobra.Command{
+		Use:   "conformance",
+		Short: "Run a directory of adapter conformance test vectors",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if vectorsDir == "" {
+				return fmt.Errorf("no vectors directory given: pass --vectors or set ALLORA_CONFORMANCE_VECTORS_DIR")
+			}
+
+			report, err := conformance.Run(vectorsDir, NewAlloraAdapter)
+			if err != nil {
+				return fmt.Errorf("failed to run conformance vectors: %w", err)
+			}
+
+			if err := conformance.WriteJUnitReport(report, reportPath); err != nil {
+				return fmt.Errorf("failed to write conformance report: %w", err)
+			}
+
+			for _, result := range report.Results {
+				status := "PASS"
+				if !result.Passed {
+					status = "FAIL"
+				}
+				fmt.Printf("[%s] %s (%s)\n", status, result.Vector.Name, result.Duration)
+				if !result.Passed {
+					fmt.Printf("       %s\n", result.Message)
+				}
+			}
+			fmt.Printf("\n%d passed, %d failed\n", report.Passed, report.Failed)
+
+			if report.Failed > 0 {
+				os.Exit(1)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&vectorsDir, "vectors", os.Getenv("ALLORA_CONFORMANCE_VECTORS_DIR"), "directory of conformance test vectors")
+	cmd.Flags().StringVar(&reportPath, "report", "conformance-report.xml", "path to write the JUnit-style XML report")
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"allora_offchain_node/journal"
+	usecase "allora_offchain_node/usecase"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// replayJournal replays j against spawner, recovering any in-flight work
+// left over from a prior crash or restart. It is called once at startup,
+// before the spawner's loop begins, against the same journal handle the
+// spawner will go on to record into, so recovered state and newly recorded
+// state share one consistent view of the journal.
+func replayJournal(spawner *usecase.UseCaseSuite, j *journal.Journal) error {
+	currentBlockHeight, err := spawner.Node.GetCurrentBlockHeight()
+	if err != nil {
+		return fmt.Errorf("could not get current block height to replay journal: %w", err)
+	}
+
+	if err := spawner.ReplayJournal(j, currentBlockHeight); err != nil {
+		return fmt.Errorf("could not replay submission journal: %w", err)
+	}
+
+	return nil
+}
+
+// newJournalCmd implements the `journal dump` subcommand, giving operators
+// an auditable, human-readable record of every in-flight and
+// recently-completed submission without needing to inspect the journal
+// file's binary format directly.
+func newJournalCmd() *cobra.Command {
+	journalCmd := &cobra.Command{
+		Use:   "journal",
+		Short: "Inspect the node's crash-restartable submission journal",
+	}
+
+	var path string
+	dumpCmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Print every entry currently in the submission journal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if path == "" {
+				userConfig, err := loadUserConfig()
+				if err != nil {
+					return fmt.Errorf("no journal path given and could not load config to default one: %w", err)
+				}
+				path = userConfig.JournalPath
+			}
+			if path == "" {
+				return fmt.Errorf("no journal path given: pass --path or set journalPath in the node config")
+			}
+
+			j, err := journal.OpenReadOnly(path)
+			if err != nil {
+				return fmt.Errorf("failed to open submission journal: %w", err)
+			}
+			defer j.Close()
+
+			entries, err := j.All()
+			if err != nil {
+				return fmt.Errorf("failed to read submission journal: %w", err)
+			}
+
+			for _, entry := range entries {
+				fmt.Printf("topic=%d role=%s nonce=%d status=%s txHash=%s blockHeight=%d updated=%d\n",
+					entry.TopicId, entry.Role, entry.Nonce, entry.Status, entry.TxHash, entry.BlockHeight, entry.UpdatedUnix)
+			}
+			fmt.Printf("\n%d entries\n", len(entries))
+			return nil
+		},
+	}
+	dumpCmd.Flags().StringVar(&path, "path", "", "path to the submission journal file (defaults to journalPath in the node config)")
+
+	journalCmd.AddCommand(dumpCmd)
+	return journalCmd
+}
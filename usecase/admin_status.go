@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"allora_offchain_node/lib"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// TopicStatuses implements lib.AdminStatusProvider, reporting the current
+// registration/stake/submission state of every worker and reputer this
+// suite is configured to serve.
+func (suite *UseCaseSuite) TopicStatuses() []lib.TopicStatus {
+	suite.statusMu.RLock()
+	defer suite.statusMu.RUnlock()
+
+	statuses := make([]lib.TopicStatus, 0, len(suite.Workers)+len(suite.Reputers))
+	for _, worker := range suite.Workers {
+		statuses = append(statuses, suite.topicStatusFor(worker.TopicId, "worker"))
+	}
+	for _, reputer := range suite.Reputers {
+		statuses = append(statuses, suite.topicStatusFor(reputer.TopicId, "reputer"))
+	}
+	return statuses
+}
+
+func (suite *UseCaseSuite) topicStatusFor(topicId uint64, role string) lib.TopicStatus {
+	out := lib.TopicStatus{TopicId: topicId, Role: role} // nolint: exhaustruct
+	if s, ok := suite.statuses[topicId]; ok {
+		out.Registered = s.registered
+		out.Stake = s.stake
+		out.LastNonce = s.lastNonce
+		out.LastError = s.lastErrStr
+	}
+	return out
+}
+
+// RegisterTopic implements lib.AdminStatusProvider, re-running the
+// idempotent registration helpers for topicId on demand rather than only at
+// startup.
+func (suite *UseCaseSuite) RegisterTopic(topicId uint64) error {
+	found := false
+	for _, worker := range suite.Workers {
+		if worker.TopicId != topicId {
+			continue
+		}
+		found = true
+		registered := suite.Node.RegisterWorkerIdempotently(worker)
+		suite.statusMu.Lock()
+		statuses := suite.ensureStatusesLocked()
+		statuses[topicId] = &topicStatus{role: "worker", registered: registered} // nolint: exhaustruct
+		suite.statusMu.Unlock()
+	}
+	for _, reputer := range suite.Reputers {
+		if reputer.TopicId != topicId {
+			continue
+		}
+		found = true
+		registered := suite.Node.RegisterAndStakeReputerIdempotently(reputer)
+		suite.statusMu.Lock()
+		statuses := suite.ensureStatusesLocked()
+		statuses[topicId] = &topicStatus{role: "reputer", registered: registered} // nolint: exhaustruct
+		suite.statusMu.Unlock()
+	}
+	if !found {
+		return fmt.Errorf("no worker or reputer config found for topic %d", topicId)
+	}
+	return nil
+}
+
+// AddStake implements lib.AdminStatusProvider.
+func (suite *UseCaseSuite) AddStake(topicId uint64, amount int64) error {
+	if err := suite.Node.AddStake(topicId, amount); err != nil {
+		return err
+	}
+	suite.refreshRecordedStake(topicId)
+	return nil
+}
+
+// RemoveStake implements lib.AdminStatusProvider.
+func (suite *UseCaseSuite) RemoveStake(topicId uint64, amount int64) error {
+	if err := suite.Node.RemoveStake(topicId, amount); err != nil {
+		return err
+	}
+	suite.refreshRecordedStake(topicId)
+	return nil
+}
+
+// refreshRecordedStake re-queries topicId's current on-chain stake and
+// records it, so /status reflects the result of AddStake/RemoveStake rather
+// than going stale until the next registration pass.
+func (suite *UseCaseSuite) refreshRecordedStake(topicId uint64) {
+	stake, err := suite.Node.GetReputerStakeInTopic(topicId, suite.Node.Chain.Address)
+	if err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Msg("Could not refresh recorded stake after admin stake change")
+		return
+	}
+	suite.recordStake(topicId, "reputer", stake.String())
+}
+
+// ReloadConfig implements lib.AdminStatusProvider. The full config reload
+// (re-reading the JSON config and replacing the suite's worker and reputer
+// configs with it) is implemented by the caller, which owns the UserConfig;
+// this hook exists so
+// the admin server has somewhere to route the request to. Callers that want
+// reload behavior should set suite.OnConfigReload.
+func (suite *UseCaseSuite) ReloadConfig() error {
+	if suite.OnConfigReload == nil {
+		return nil
+	}
+	return suite.OnConfigReload()
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterTopicErrorsWhenNoConfigMatches(t *testing.T) {
+	suite := &UseCaseSuite{Workers: nil, Reputers: nil} // nolint: exhaustruct
+
+	err := suite.RegisterTopic(7)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no worker or reputer config found for topic 7")
+}
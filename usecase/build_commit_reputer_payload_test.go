@@ -3,6 +3,7 @@ package usecase
 import (
 	"allora_offchain_node/lib"
 	"errors"
+	"fmt"
 	"testing"
 
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -149,3 +150,47 @@ func TestComputeLossBundle(t *testing.T) {
 		})
 	}
 }
+
+// TestComputeLossBundleConcurrentOrderingPreserved exercises the worker-pool
+// fan-out path (LossComputeConcurrency > 1, more than lossComputeThreshold
+// calls) and checks that each inferer's loss still lands at that inferer's
+// own position in the result bundle, not wherever its goroutine happened to
+// finish.
+func TestComputeLossBundleConcurrentOrderingPreserved(t *testing.T) {
+	const numInferers = 12
+	lossOptions := map[string]string{"method": "sqe"}
+	reputerConfig := lib.ReputerConfig{ // nolint: exhaustruct
+		LossFunctionParameters: lib.LossFunctionParameters{ // nolint: exhaustruct
+			LossMethodOptions: lossOptions,
+			IsNeverNegative:   &[]bool{false}[0],
+		},
+		LossComputeConcurrency: 4,
+	}
+
+	mockAdapter := ReturnBasicMockAlloraAdapter()
+	reputerConfig.GroundTruthEntrypoint = mockAdapter
+	reputerConfig.LossFunctionEntrypoint = mockAdapter
+
+	combined, _ := alloraMath.NewDecFromString("9.5")
+	valueBundle := &emissionstypes.ValueBundle{ // nolint: exhaustruct
+		CombinedValue: combined,
+		InfererValues: make([]*emissionstypes.WorkerAttributedValue, numInferers),
+	}
+	mockAdapter.On("LossFunction", mock.AnythingOfType("lib.ReputerConfig"), "10.0", "9.5", lossOptions).Return("0.25", nil)
+	for i := 0; i < numInferers; i++ {
+		infererValue := fmt.Sprintf("%d.0", i)
+		expectedLoss := fmt.Sprintf("0.%03d", i)
+		v, _ := alloraMath.NewDecFromString(infererValue)
+		valueBundle.InfererValues[i] = &emissionstypes.WorkerAttributedValue{Worker: fmt.Sprintf("worker-%d", i), Value: v}
+		mockAdapter.On("LossFunction", mock.AnythingOfType("lib.ReputerConfig"), "10.0", infererValue, lossOptions).Return(expectedLoss, nil)
+	}
+
+	suite := &UseCaseSuite{} // nolint: exhaustruct
+	result, err := suite.ComputeLossBundle("10.0", valueBundle, reputerConfig)
+
+	require.NoError(t, err)
+	for i := 0; i < numInferers; i++ {
+		assert.Equal(t, fmt.Sprintf("0.%03d", i), result.InfererValues[i].Value.String(), "loss at position %d must match its own inferer's value", i)
+	}
+	mockAdapter.AssertExpectations(t)
+}
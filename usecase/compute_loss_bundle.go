@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"allora_offchain_node/lib"
+	"fmt"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// lossComputeThreshold is the number of individual LossFunction calls a
+// ValueBundle must exceed before ComputeLossBundle bothers fanning them out
+// to a worker pool; at or below it the serial path's lower overhead wins.
+const lossComputeThreshold = 8
+
+// lossJob is one CombinedValue/NaiveValue/WorkerAttributedValue entry
+// awaiting a LossFunction call; apply writes the parsed result back into its
+// original position in the ValueBundle being built, so that position is
+// correct regardless of which goroutine finishes the call first.
+type lossJob struct {
+	label string
+	value string
+	apply func(alloraMath.Dec)
+}
+
+// ComputeLossBundle invokes the reputer's LossFunction adapter against the
+// source-of-truth value and every value in valueBundle (combined, naive, and
+// each per-worker inferer/forecaster/one-out/one-in value), returning a new
+// ValueBundle of losses in the same shape.
+//
+// By default losses are computed serially, call by call, matching prior
+// behavior. When reputerConfig.LossComputeConcurrency is greater than 1 and
+// the bundle requires more than lossComputeThreshold calls, the calls are
+// instead dispatched to a bounded worker pool; results are still written
+// back into their original slice positions so bundle ordering - which the
+// chain expects to line up with the corresponding ValueBundle - is
+// unaffected by dispatch order.
+func (suite *UseCaseSuite) ComputeLossBundle(sourceTruth string, valueBundle *emissionstypes.ValueBundle, reputerConfig lib.ReputerConfig) (*emissionstypes.ValueBundle, error) {
+	if valueBundle == nil {
+		return nil, fmt.Errorf("cannot compute loss bundle: nil ValueBundle")
+	}
+	if valueBundle.CombinedValue.IsNil() {
+		return nil, fmt.Errorf("cannot compute loss bundle: empty ValueBundle")
+	}
+
+	options := reputerConfig.LossFunctionParameters.LossMethodOptions
+	isNeverNegative := reputerConfig.LossFunctionParameters.IsNeverNegative != nil && *reputerConfig.LossFunctionParameters.IsNeverNegative
+	zero, err := alloraMath.NewDecFromString("0")
+	if err != nil {
+		return nil, fmt.Errorf("error constructing zero value: %w", err)
+	}
+
+	result := &emissionstypes.ValueBundle{ // nolint: exhaustruct
+		InfererValues:          make([]*emissionstypes.WorkerAttributedValue, len(valueBundle.InfererValues)),
+		ForecasterValues:       make([]*emissionstypes.WorkerAttributedValue, len(valueBundle.ForecasterValues)),
+		OneOutInfererValues:    make([]*emissionstypes.WithheldWorkerAttributedValue, len(valueBundle.OneOutInfererValues)),
+		OneOutForecasterValues: make([]*emissionstypes.WithheldWorkerAttributedValue, len(valueBundle.OneOutForecasterValues)),
+		OneInForecasterValues:  make([]*emissionstypes.WorkerAttributedValue, len(valueBundle.OneInForecasterValues)),
+	}
+
+	jobs := make([]lossJob, 0, 2+len(valueBundle.InfererValues)+len(valueBundle.ForecasterValues)+len(valueBundle.OneOutInfererValues)+len(valueBundle.OneOutForecasterValues)+len(valueBundle.OneInForecasterValues))
+
+	jobs = append(jobs, lossJob{
+		label: "combined value",
+		value: valueBundle.CombinedValue.String(),
+		apply: func(d alloraMath.Dec) { result.CombinedValue = d },
+	})
+	if !valueBundle.NaiveValue.IsNil() {
+		jobs = append(jobs, lossJob{
+			label: "naive value",
+			value: valueBundle.NaiveValue.String(),
+			apply: func(d alloraMath.Dec) { result.NaiveValue = d },
+		})
+	}
+	for i, v := range valueBundle.InfererValues {
+		i := i
+		result.InfererValues[i] = &emissionstypes.WorkerAttributedValue{Worker: v.Worker} // nolint: exhaustruct
+		jobs = append(jobs, lossJob{
+			label: fmt.Sprintf("inferer value %d", i),
+			value: v.Value.String(),
+			apply: func(d alloraMath.Dec) { result.InfererValues[i].Value = d },
+		})
+	}
+	for i, v := range valueBundle.ForecasterValues {
+		i := i
+		result.ForecasterValues[i] = &emissionstypes.WorkerAttributedValue{Worker: v.Worker} // nolint: exhaustruct
+		jobs = append(jobs, lossJob{
+			label: fmt.Sprintf("forecaster value %d", i),
+			value: v.Value.String(),
+			apply: func(d alloraMath.Dec) { result.ForecasterValues[i].Value = d },
+		})
+	}
+	for i, v := range valueBundle.OneOutInfererValues {
+		i := i
+		result.OneOutInfererValues[i] = &emissionstypes.WithheldWorkerAttributedValue{Worker: v.Worker} // nolint: exhaustruct
+		jobs = append(jobs, lossJob{
+			label: fmt.Sprintf("one-out inferer value %d", i),
+			value: v.Value.String(),
+			apply: func(d alloraMath.Dec) { result.OneOutInfererValues[i].Value = d },
+		})
+	}
+	for i, v := range valueBundle.OneOutForecasterValues {
+		i := i
+		result.OneOutForecasterValues[i] = &emissionstypes.WithheldWorkerAttributedValue{Worker: v.Worker} // nolint: exhaustruct
+		jobs = append(jobs, lossJob{
+			label: fmt.Sprintf("one-out forecaster value %d", i),
+			value: v.Value.String(),
+			apply: func(d alloraMath.Dec) { result.OneOutForecasterValues[i].Value = d },
+		})
+	}
+	for i, v := range valueBundle.OneInForecasterValues {
+		i := i
+		result.OneInForecasterValues[i] = &emissionstypes.WorkerAttributedValue{Worker: v.Worker} // nolint: exhaustruct
+		jobs = append(jobs, lossJob{
+			label: fmt.Sprintf("one-in forecaster value %d", i),
+			value: v.Value.String(),
+			apply: func(d alloraMath.Dec) { result.OneInForecasterValues[i].Value = d },
+		})
+	}
+
+	computeOne := func(job lossJob) error {
+		lossStr, err := reputerConfig.LossFunctionEntrypoint.LossFunction(reputerConfig, sourceTruth, job.value, options)
+		if err != nil {
+			return fmt.Errorf("error computing loss for %s: %w", job.label, err)
+		}
+		lossDec, err := alloraMath.NewDecFromString(lossStr)
+		if err != nil {
+			return fmt.Errorf("error parsing loss for %s: %w", job.label, err)
+		}
+		if isNeverNegative && lossDec.Lt(zero) {
+			lossDec = zero
+		}
+		job.apply(lossDec)
+		return nil
+	}
+
+	if reputerConfig.LossComputeConcurrency > 1 && len(jobs) > lossComputeThreshold {
+		g := new(errgroup.Group)
+		g.SetLimit(reputerConfig.LossComputeConcurrency)
+		for _, job := range jobs {
+			job := job
+			g.Go(func() error { return computeOne(job) })
+		}
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, job := range jobs {
+			if err := computeOne(job); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
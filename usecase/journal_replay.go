@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"allora_offchain_node/journal"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// staleBroadcastBlocks is how many blocks a tx_broadcast journal entry can
+// sit without a confirmed/failed follow-up before ReplayJournal treats it
+// as stale and re-queries its final on-chain status.
+const staleBroadcastBlocks = 20
+
+// journalRetention bounds how long any journal entry is kept around: at the
+// end of every ReplayJournal, entries last updated longer ago than this are
+// deleted whatever their status or topic. It is a backstop against
+// unbounded journal growth (e.g. entries for a topic that can no longer be
+// queried), not the primary expiry mechanism - entries for topics that can
+// still be queried expire via their topic's epoch instead.
+const journalRetention = 30 * 24 * time.Hour
+
+// ReplayJournal recovers in-flight work the node was doing when it last
+// stopped, whether cleanly or via crash. tx_broadcast entries older than
+// staleBroadcastBlocks are re-queried on-chain to determine their final
+// status; adapter_called entries whose nonces are still open on-chain are
+// flagged as pending resubmission through the topic's /status error, and
+// those whose nonces have closed are deleted. The journal does not store
+// the adapter's output, so the resubmission itself happens on Spawn's next
+// pass over the still-open nonce, which calls the adapter again. Entries
+// belonging to a topic epoch that has already ended are expired outright,
+// since the nonce they were submitted against can no longer be actioned.
+func (suite *UseCaseSuite) ReplayJournal(j *journal.Journal, currentBlockHeight int64) error {
+	entries, err := j.All()
+	if err != nil {
+		return fmt.Errorf("could not load journal entries: %w", err)
+	}
+
+	epochLengths := make(map[uint64]int64)
+
+	for _, entry := range entries {
+		isReputer := entry.Role == "reputer"
+
+		expired, err := suite.entryEpochExpired(entry, currentBlockHeight, epochLengths)
+		if err != nil {
+			log.Error().Err(err).Uint64("topicId", entry.TopicId).Int64("nonce", entry.Nonce).Msg("Could not check topic epoch for journal entry, leaving it as-is")
+		} else if expired {
+			log.Info().Uint64("topicId", entry.TopicId).Int64("nonce", entry.Nonce).Msg("Topic epoch has passed for journal entry; expiring it")
+			if err := j.Delete(entry.TopicId, entry.Nonce, entry.Role); err != nil {
+				return fmt.Errorf("could not expire journal entry for topic %d nonce %d: %w", entry.TopicId, entry.Nonce, err)
+			}
+			continue
+		}
+
+		switch entry.Status {
+		case journal.StatusTxBroadcast:
+			if currentBlockHeight-entry.BlockHeight < staleBroadcastBlocks {
+				continue
+			}
+			open, err := suite.Node.IsNonceOpen(entry.TopicId, entry.Nonce, isReputer)
+			if err != nil {
+				log.Error().Err(err).Uint64("topicId", entry.TopicId).Int64("nonce", entry.Nonce).Msg("Could not re-query stale tx_broadcast journal entry, leaving it as-is")
+				continue
+			}
+			if open {
+				log.Warn().Uint64("topicId", entry.TopicId).Int64("nonce", entry.Nonce).Str("txHash", entry.TxHash).Msg("Journal entry still marked broadcast but nonce remains open on-chain; marking failed for resubmission")
+				entry.Status = journal.StatusTxFailed
+			} else {
+				entry.Status = journal.StatusTxConfirmed
+			}
+			if err := j.Record(entry); err != nil {
+				return fmt.Errorf("could not update journal entry for topic %d nonce %d: %w", entry.TopicId, entry.Nonce, err)
+			}
+
+		case journal.StatusAdapterCalled:
+			open, err := suite.Node.IsNonceOpen(entry.TopicId, entry.Nonce, isReputer)
+			if err != nil {
+				log.Error().Err(err).Uint64("topicId", entry.TopicId).Int64("nonce", entry.Nonce).Msg("Could not check if adapter_called journal entry is still open, leaving it as-is")
+				continue
+			}
+			if !open {
+				if err := j.Delete(entry.TopicId, entry.Nonce, entry.Role); err != nil {
+					return fmt.Errorf("could not clear stale journal entry for topic %d nonce %d: %w", entry.TopicId, entry.Nonce, err)
+				}
+				continue
+			}
+			log.Info().Uint64("topicId", entry.TopicId).Int64("nonce", entry.Nonce).Msg("Flagging journal entry left in adapter_called state by a prior crash for resubmission")
+			suite.recordError(entry.TopicId, entry.Role, fmt.Errorf("resubmission pending after restart for nonce %d", entry.Nonce))
+		}
+	}
+
+	expiredCount, err := j.ExpireBefore(time.Now().Add(-journalRetention).Unix())
+	if err != nil {
+		return fmt.Errorf("could not expire stale journal entries: %w", err)
+	}
+	if expiredCount > 0 {
+		log.Info().Int("count", expiredCount).Msg("Expired stale journal entries older than the retention window")
+	}
+
+	return nil
+}
+
+// entryEpochExpired reports whether entry's topic has moved into an epoch
+// after the one entry.BlockHeight fell in, meaning the nonce it was
+// submitted against is no longer actionable. epochLengths caches each
+// topic's epoch length (which is stable for the topic) for the duration of
+// one ReplayJournal call, since several entries commonly belong to the same
+// topic but at different nonces/block heights - the epoch boundary itself
+// must still be computed per entry, from that entry's own BlockHeight.
+func (suite *UseCaseSuite) entryEpochExpired(entry journal.Entry, currentBlockHeight int64, epochLengths map[uint64]int64) (bool, error) {
+	epochLength, ok := epochLengths[entry.TopicId]
+	if !ok {
+		info, err := suite.Node.GetTopicInfo(entry.TopicId)
+		if err != nil {
+			return false, fmt.Errorf("could not get topic info for topic %d: %w", entry.TopicId, err)
+		}
+		epochLength = info.EpochLength
+		epochLengths[entry.TopicId] = epochLength
+	}
+	epochEnd := epochEndForBlockHeight(entry.BlockHeight, epochLength)
+	if epochEnd == 0 {
+		return false, nil
+	}
+	return currentBlockHeight > epochEnd, nil
+}
+
+// epochEndForBlockHeight returns the block height at which the epoch
+// containing blockHeight ends, for a topic with the given epochLength, or 0
+// if epochLength isn't positive (no epoch boundary to expire against). It's
+// pure arithmetic, kept separate from entryEpochExpired so the epoch-boundary
+// math can be tested without a chain connection.
+func epochEndForBlockHeight(blockHeight int64, epochLength int64) int64 {
+	if epochLength <= 0 {
+		return 0
+	}
+	return blockHeight + (epochLength - blockHeight%epochLength)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochEndForBlockHeight(t *testing.T) {
+	cases := []struct {
+		name        string
+		blockHeight int64
+		epochLength int64
+		want        int64
+	}{
+		{name: "zero epoch length has no boundary", blockHeight: 105, epochLength: 0, want: 0},
+		{name: "negative epoch length has no boundary", blockHeight: 105, epochLength: -1, want: 0},
+		{name: "mid epoch rounds up to the next boundary", blockHeight: 105, epochLength: 10, want: 110},
+		{name: "on a boundary rounds up to the next one", blockHeight: 110, epochLength: 10, want: 120},
+		// Regression: two entries for the same topic at different block
+		// heights must get different epoch ends. Before entryEpochExpired
+		// cached the epoch length (rather than the computed epoch end) per
+		// topic, the second entry here would have wrongly reused the
+		// first's boundary (110) instead of its own (310).
+		{name: "entry A of a same-topic pair", blockHeight: 100, epochLength: 10, want: 110},
+		{name: "entry B of a same-topic pair", blockHeight: 300, epochLength: 10, want: 310},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.want, epochEndForBlockHeight(c.blockHeight, c.epochLength))
+		})
+	}
+}
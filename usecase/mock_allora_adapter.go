@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"allora_offchain_node/lib"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAlloraAdapter is a testify-based stand-in for lib.AlloraAdapter, used
+// to exercise UseCaseSuite logic without making real adapter calls.
+type MockAlloraAdapter struct {
+	mock.Mock
+}
+
+func (m *MockAlloraAdapter) Inference(config lib.WorkerConfig, blockHeight int64) (string, error) {
+	args := m.Called(config, blockHeight)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAlloraAdapter) Forecast(config lib.WorkerConfig, blockHeight int64, infererValue string) (string, error) {
+	args := m.Called(config, blockHeight, infererValue)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAlloraAdapter) TruthSourceValue(config lib.ReputerConfig, blockHeight int64) (string, error) {
+	args := m.Called(config, blockHeight)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAlloraAdapter) LossFunction(config lib.ReputerConfig, sourceTruth string, value string, options map[string]string) (string, error) {
+	args := m.Called(config, sourceTruth, value, options)
+	return args.String(0), args.Error(1)
+}
+
+// ReturnBasicMockAlloraAdapter returns a MockAlloraAdapter with no
+// expectations set; callers configure behavior via m.On(...) before use.
+func ReturnBasicMockAlloraAdapter() *MockAlloraAdapter {
+	return &MockAlloraAdapter{}
+}
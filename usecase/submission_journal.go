@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"allora_offchain_node/journal"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RecordQueued journals that topicId/nonce has been picked up for
+// submission, before its adapter has been invoked. It is a no-op if the
+// suite was not configured with a journal.
+func (suite *UseCaseSuite) RecordQueued(topicId uint64, role string, nonce int64, blockHeight int64) error {
+	return suite.recordJournalStatus(topicId, role, nonce, blockHeight, "", journal.StatusQueued)
+}
+
+// RecordAdapterCalled journals that the worker/reputer adapter has returned
+// a value for topicId/nonce and a tx is about to be broadcast. If the node
+// crashes before the follow-up RecordTxBroadcast/RecordTxFailed call,
+// ReplayJournal flags this nonce as pending resubmission if it is still
+// open. The adapter's output is not journaled, so that resubmission calls
+// the adapter again.
+func (suite *UseCaseSuite) RecordAdapterCalled(topicId uint64, role string, nonce int64, blockHeight int64) error {
+	return suite.recordJournalStatus(topicId, role, nonce, blockHeight, "", journal.StatusAdapterCalled)
+}
+
+// RecordTxBroadcast journals that a tx for topicId/nonce has been broadcast
+// with the given hash, at the given block height.
+func (suite *UseCaseSuite) RecordTxBroadcast(topicId uint64, role string, nonce int64, blockHeight int64, txHash string) error {
+	return suite.recordJournalStatus(topicId, role, nonce, blockHeight, txHash, journal.StatusTxBroadcast)
+}
+
+// RecordTxConfirmed journals that topicId/nonce's tx landed on chain, and
+// records it as the topic's last submitted nonce for the admin server's
+// /status endpoint.
+func (suite *UseCaseSuite) RecordTxConfirmed(topicId uint64, role string, nonce int64, blockHeight int64, txHash string) error {
+	if err := suite.recordJournalStatus(topicId, role, nonce, blockHeight, txHash, journal.StatusTxConfirmed); err != nil {
+		return err
+	}
+	suite.recordLastNonce(topicId, role, nonce)
+	return nil
+}
+
+// RecordTxFailed journals that topicId/nonce's tx failed to land on chain,
+// and surfaces submitErr through the admin server's /status endpoint.
+func (suite *UseCaseSuite) RecordTxFailed(topicId uint64, role string, nonce int64, blockHeight int64, submitErr error) error {
+	if err := suite.recordJournalStatus(topicId, role, nonce, blockHeight, "", journal.StatusTxFailed); err != nil {
+		return err
+	}
+	suite.recordError(topicId, role, submitErr)
+	return nil
+}
+
+func (suite *UseCaseSuite) recordJournalStatus(topicId uint64, role string, nonce int64, blockHeight int64, txHash string, status journal.Status) error {
+	if suite.Journal == nil {
+		return nil
+	}
+	entry := journal.Entry{ // nolint: exhaustruct
+		TopicId:     topicId,
+		Nonce:       nonce,
+		Role:        role,
+		Status:      status,
+		TxHash:      txHash,
+		BlockHeight: blockHeight,
+	}
+	if err := suite.Journal.Record(entry); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Str("status", string(status)).Msg("Could not write submission journal entry")
+		return fmt.Errorf("could not record journal entry for topic %d nonce %d: %w", topicId, nonce, err)
+	}
+	return nil
+}
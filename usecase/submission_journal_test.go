@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"allora_offchain_node/journal"
+	"allora_offchain_node/lib"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSuiteWithJournal(t *testing.T) *UseCaseSuite {
+	t.Helper()
+	j, err := journal.Open(filepath.Join(t.TempDir(), "journal.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = j.Close() })
+
+	return &UseCaseSuite{ // nolint: exhaustruct
+		Workers: []lib.WorkerConfig{{TopicId: 1}}, // nolint: exhaustruct
+		Journal: j,
+	}
+}
+
+func TestRecordTxConfirmedUpdatesJournalAndStatus(t *testing.T) {
+	suite := newTestSuiteWithJournal(t)
+
+	require.NoError(t, suite.RecordQueued(1, "worker", 100, 10))
+	require.NoError(t, suite.RecordAdapterCalled(1, "worker", 100, 10))
+	require.NoError(t, suite.RecordTxBroadcast(1, "worker", 100, 11, "0xabc"))
+	require.NoError(t, suite.RecordTxConfirmed(1, "worker", 100, 12, "0xabc"))
+
+	entries, err := suite.Journal.All()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, journal.StatusTxConfirmed, entries[0].Status)
+	require.Equal(t, int64(100), entries[0].Nonce)
+
+	statuses := suite.TopicStatuses()
+	require.Len(t, statuses, 1)
+	require.EqualValues(t, 100, statuses[0].LastNonce)
+	require.Empty(t, statuses[0].LastError)
+}
+
+func TestRecordTxFailedSurfacesErrorThroughStatus(t *testing.T) {
+	suite := newTestSuiteWithJournal(t)
+
+	require.NoError(t, suite.RecordAdapterCalled(1, "worker", 200, 10))
+	require.NoError(t, suite.RecordTxFailed(1, "worker", 200, 11, fmt.Errorf("broadcast rejected")))
+
+	statuses := suite.TopicStatuses()
+	require.Len(t, statuses, 1)
+	require.Contains(t, statuses[0].LastError, "broadcast rejected")
+}
+
+func TestRecordingIsNoOpWithoutJournal(t *testing.T) {
+	suite := &UseCaseSuite{} // nolint: exhaustruct
+	require.NoError(t, suite.RecordQueued(1, "worker", 1, 1))
+	require.NoError(t, suite.RecordTxConfirmed(1, "worker", 1, 1, "0xabc"))
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"allora_offchain_node/lib"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// spawnPollInterval is how often Spawn checks suite.Workers and
+// suite.Reputers for newly opened nonces.
+const spawnPollInterval = 5 * time.Second
+
+// Spawn is the node's main run loop: on every tick, it submits for every
+// currently open worker and reputer nonce, recording each submission's
+// lifecycle into suite.Journal (if configured) as it goes. It returns once
+// ctx is canceled.
+func (suite *UseCaseSuite) Spawn(ctx context.Context) {
+	ticker := time.NewTicker(spawnPollInterval)
+	defer ticker.Stop()
+
+	for {
+		suite.submitOpenNonces()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (suite *UseCaseSuite) submitOpenNonces() {
+	for _, worker := range suite.Workers {
+		suite.submitOpenWorkerNonces(worker)
+	}
+	for _, reputer := range suite.Reputers {
+		suite.submitOpenReputerNonces(reputer)
+	}
+}
+
+func (suite *UseCaseSuite) submitOpenWorkerNonces(worker lib.WorkerConfig) {
+	nonces, err := suite.Node.OpenWorkerNonces(worker.TopicId)
+	if err != nil {
+		log.Error().Err(err).Uint64("topicId", worker.TopicId).Msg("Could not query open worker nonces")
+		suite.recordError(worker.TopicId, "worker", err)
+		return
+	}
+	for _, nonce := range nonces {
+		if !suite.claimNonce(worker.TopicId, "worker", nonce) {
+			continue
+		}
+		suite.submitWorkerNonce(worker, nonce)
+	}
+}
+
+func (suite *UseCaseSuite) submitOpenReputerNonces(reputer lib.ReputerConfig) {
+	nonces, err := suite.Node.OpenReputerNonces(reputer.TopicId)
+	if err != nil {
+		log.Error().Err(err).Uint64("topicId", reputer.TopicId).Msg("Could not query open reputer nonces")
+		suite.recordError(reputer.TopicId, "reputer", err)
+		return
+	}
+	for _, nonce := range nonces {
+		if !suite.claimNonce(reputer.TopicId, "reputer", nonce) {
+			continue
+		}
+		suite.submitReputerNonce(reputer, nonce)
+	}
+}
+
+// submitWorkerNonce runs worker's inference (and forecast, if configured)
+// for nonce and broadcasts the result, recording each stage into the
+// journal: queued before the adapter is called, adapter_called once it
+// returns, then tx_broadcast/tx_confirmed/tx_failed around the chain call.
+func (suite *UseCaseSuite) submitWorkerNonce(worker lib.WorkerConfig, nonce int64) {
+	topicId := worker.TopicId
+
+	if err := suite.RecordQueued(topicId, "worker", nonce, nonce); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record queued worker submission")
+	}
+
+	inferenceValue, err := worker.InferenceEntrypoint.Inference(worker, nonce)
+	if err != nil {
+		suite.failWorkerSubmission(topicId, nonce, fmt.Errorf("inference failed: %w", err))
+		return
+	}
+
+	forecastValue := ""
+	if worker.ForecastEntrypoint != nil {
+		forecastValue, err = worker.ForecastEntrypoint.Forecast(worker, nonce, inferenceValue)
+		if err != nil {
+			suite.failWorkerSubmission(topicId, nonce, fmt.Errorf("forecast failed: %w", err))
+			return
+		}
+	}
+
+	if err := suite.RecordAdapterCalled(topicId, "worker", nonce, nonce); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record adapter-called worker submission")
+	}
+
+	txHash, err := suite.Node.SubmitWorkerPayload(topicId, nonce, inferenceValue, forecastValue)
+	if err != nil {
+		suite.failWorkerSubmission(topicId, nonce, err)
+		return
+	}
+	if err := suite.RecordTxBroadcast(topicId, "worker", nonce, nonce, txHash); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record tx_broadcast for worker submission")
+	}
+
+	open, err := suite.Node.IsNonceOpen(topicId, nonce, false)
+	if err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not confirm worker submission landed; leaving it as broadcast for ReplayJournal to re-check")
+		suite.recordLastNonce(topicId, "worker", nonce)
+		return
+	}
+	if open {
+		suite.failWorkerSubmission(topicId, nonce, fmt.Errorf("nonce still open on-chain after broadcast"))
+		return
+	}
+	if err := suite.RecordTxConfirmed(topicId, "worker", nonce, nonce, txHash); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record tx_confirmed for worker submission")
+	}
+}
+
+func (suite *UseCaseSuite) failWorkerSubmission(topicId uint64, nonce int64, cause error) {
+	log.Error().Err(cause).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Worker submission failed")
+	if err := suite.RecordTxFailed(topicId, "worker", nonce, nonce, cause); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record tx_failed for worker submission")
+	}
+}
+
+// submitReputerNonce computes reputer's loss bundle against the network's
+// current values for nonce and broadcasts it, recording each stage into the
+// journal the same way submitWorkerNonce does for workers.
+func (suite *UseCaseSuite) submitReputerNonce(reputer lib.ReputerConfig, nonce int64) {
+	topicId := reputer.TopicId
+
+	if err := suite.RecordQueued(topicId, "reputer", nonce, nonce); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record queued reputer submission")
+	}
+
+	sourceTruth, err := reputer.GroundTruthEntrypoint.TruthSourceValue(reputer, nonce)
+	if err != nil {
+		suite.failReputerSubmission(topicId, nonce, fmt.Errorf("ground truth lookup failed: %w", err))
+		return
+	}
+
+	networkValues, err := suite.Node.GetNetworkValueBundle(topicId, nonce)
+	if err != nil {
+		suite.failReputerSubmission(topicId, nonce, fmt.Errorf("could not fetch network values: %w", err))
+		return
+	}
+
+	lossBundle, err := suite.ComputeLossBundle(sourceTruth, networkValues, reputer)
+	if err != nil {
+		suite.failReputerSubmission(topicId, nonce, fmt.Errorf("loss computation failed: %w", err))
+		return
+	}
+
+	if err := suite.RecordAdapterCalled(topicId, "reputer", nonce, nonce); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record adapter-called reputer submission")
+	}
+
+	txHash, err := suite.Node.SubmitReputerPayload(topicId, nonce, lossBundle)
+	if err != nil {
+		suite.failReputerSubmission(topicId, nonce, err)
+		return
+	}
+	if err := suite.RecordTxBroadcast(topicId, "reputer", nonce, nonce, txHash); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record tx_broadcast for reputer submission")
+	}
+
+	open, err := suite.Node.IsNonceOpen(topicId, nonce, true)
+	if err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not confirm reputer submission landed; leaving it as broadcast for ReplayJournal to re-check")
+		suite.recordLastNonce(topicId, "reputer", nonce)
+		return
+	}
+	if open {
+		suite.failReputerSubmission(topicId, nonce, fmt.Errorf("nonce still open on-chain after broadcast"))
+		return
+	}
+	if err := suite.RecordTxConfirmed(topicId, "reputer", nonce, nonce, txHash); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record tx_confirmed for reputer submission")
+	}
+}
+
+func (suite *UseCaseSuite) failReputerSubmission(topicId uint64, nonce int64, cause error) {
+	log.Error().Err(cause).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Reputer submission failed")
+	if err := suite.RecordTxFailed(topicId, "reputer", nonce, nonce, cause); err != nil {
+		log.Error().Err(err).Uint64("topicId", topicId).Int64("nonce", nonce).Msg("Could not record tx_failed for reputer submission")
+	}
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"allora_offchain_node/journal"
+	"allora_offchain_node/lib"
+	"fmt"
+	"sync"
+)
+
+// topicStatus is the last observed registration/stake/submission state for
+// one topic the suite is configured to serve, either as a worker or as a
+// reputer. It's updated as the spawner does its work and read back out
+// through the admin server's /status endpoint.
+type topicStatus struct {
+	role       string // "worker" or "reputer"
+	registered bool
+	stake      string
+	lastNonce  int64
+	lastErrStr string
+}
+
+// UseCaseSuite is the live configuration and state the offchain node spawner
+// uses to drive a single worker/reputer submission loop.
+type UseCaseSuite struct {
+	Node     lib.NodeConfig
+	Metrics  lib.Metrics
+	Workers  []lib.WorkerConfig
+	Reputers []lib.ReputerConfig
+
+	// OnConfigReload is invoked by ReloadConfig (called from the admin
+	// server's /config/reload endpoint) to re-read and apply the node's
+	// JSON config. It is set by whoever constructs the suite, since only
+	// they own the UserConfig this suite was built from.
+	OnConfigReload func() error
+
+	// JournalPath is the on-disk location of the suite's submission journal,
+	// taken from UserConfig.JournalPath. It is empty if the node wasn't
+	// configured with one, in which case the journal is disabled entirely.
+	JournalPath string
+
+	// Journal is the suite's crash-restartable submission journal, opened
+	// from JournalPath by whoever runs the suite. It is nil unless the node
+	// was configured with a journal path, in which case the submission loop
+	// records each nonce's lifecycle into it via RecordQueued/
+	// RecordAdapterCalled/RecordTxBroadcast/RecordTxConfirmed/
+	// RecordTxFailed, and ReplayJournal recovers from it on startup.
+	Journal *journal.Journal
+
+	statusMu sync.RWMutex
+	statuses map[uint64]*topicStatus
+
+	nonceMu       sync.Mutex
+	claimedNonces map[string]bool
+}
+
+// claimNonce reports whether this is the first time Spawn has seen the given
+// topic/role/nonce in this process's lifetime. Spawn polls for open nonces
+// on an interval, and a nonce stays open on-chain until it's fulfilled, so
+// without this a still-open nonce would be resubmitted on every poll.
+func (suite *UseCaseSuite) claimNonce(topicId uint64, role string, nonce int64) bool {
+	suite.nonceMu.Lock()
+	defer suite.nonceMu.Unlock()
+	if suite.claimedNonces == nil {
+		suite.claimedNonces = make(map[string]bool)
+	}
+	key := fmt.Sprintf("%d:%s:%d", topicId, role, nonce)
+	if suite.claimedNonces[key] {
+		return false
+	}
+	suite.claimedNonces[key] = true
+	return true
+}
+
+// ensureStatusesLocked returns suite.statuses, initializing it if necessary.
+// Callers must already hold statusMu for writing.
+func (suite *UseCaseSuite) ensureStatusesLocked() map[uint64]*topicStatus {
+	if suite.statuses == nil {
+		suite.statuses = make(map[uint64]*topicStatus)
+	}
+	return suite.statuses
+}
+
+// recordLastNonce updates the last nonce the suite attempted to submit
+// against for topicId, and clears any previously recorded error.
+func (suite *UseCaseSuite) recordLastNonce(topicId uint64, role string, nonce int64) {
+	suite.statusMu.Lock()
+	defer suite.statusMu.Unlock()
+	statuses := suite.ensureStatusesLocked()
+	s, ok := statuses[topicId]
+	if !ok {
+		s = &topicStatus{role: role} // nolint: exhaustruct
+		statuses[topicId] = s
+	}
+	s.lastNonce = nonce
+	s.lastErrStr = ""
+}
+
+// recordStake updates the last observed stake amount for topicId, e.g.
+// after a successful AddStake/RemoveStake call.
+func (suite *UseCaseSuite) recordStake(topicId uint64, role string, stake string) {
+	suite.statusMu.Lock()
+	defer suite.statusMu.Unlock()
+	statuses := suite.ensureStatusesLocked()
+	s, ok := statuses[topicId]
+	if !ok {
+		s = &topicStatus{role: role} // nolint: exhaustruct
+		statuses[topicId] = s
+	}
+	s.stake = stake
+}
+
+// recordError records the last error encountered while servicing topicId,
+// surfaced later via the admin server's /status endpoint.
+func (suite *UseCaseSuite) recordError(topicId uint64, role string, err error) {
+	suite.statusMu.Lock()
+	defer suite.statusMu.Unlock()
+	statuses := suite.ensureStatusesLocked()
+	s, ok := statuses[topicId]
+	if !ok {
+		s = &topicStatus{role: role} // nolint: exhaustruct
+		statuses[topicId] = s
+	}
+	s.lastErrStr = err.Error()
+}